@@ -0,0 +1,97 @@
+package cslice
+
+import "context"
+
+// NewBlockingConcurrentSlice creates a new concurrent slice for producer/consumer
+// use via BlockingPop, BlockingPopBack and WaitForCount.
+//
+// Every ConcurrentSlice already carries the *sync.Cond its blocking
+// operations need (see newConcurrentSlice), so this returns the concrete
+// *ConcurrentSlice[T] rather than the List[T] interface — BlockingPop,
+// BlockingPopBack and WaitForCount are not part of List[T], and callers that
+// want them should not have to type-assert to reach them.
+func NewBlockingConcurrentSlice[T any]() *ConcurrentSlice[T] {
+	return newConcurrentSlice[T]()
+}
+
+// BlockingPop waits until the slice holds at least one item, then removes
+// and returns the item at index 0. It returns ctx.Err() if ctx is cancelled
+// before an item becomes available.
+func (cs *ConcurrentSlice[T]) BlockingPop(ctx context.Context) (T, error) {
+	return cs.blockingRemove(ctx, true)
+}
+
+// BlockingPopBack waits until the slice holds at least one item, then
+// removes and returns the last item. It returns ctx.Err() if ctx is
+// cancelled before an item becomes available.
+func (cs *ConcurrentSlice[T]) BlockingPopBack(ctx context.Context) (T, error) {
+	return cs.blockingRemove(ctx, false)
+}
+
+// blockingRemove waits for at least one item and pops it from the front or
+// back depending on front. A goroutine watches ctx.Done() and broadcasts on
+// the condition variable so a waiting Wait() call unblocks promptly on
+// cancellation.
+func (cs *ConcurrentSlice[T]) blockingRemove(ctx context.Context, front bool) (T, error) {
+	var zero T
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			cs.mutex.Lock()
+			cs.cond.Broadcast()
+			cs.mutex.Unlock()
+		case <-done:
+		}
+	}()
+
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	for len(cs.items) == 0 {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		cs.cond.Wait()
+	}
+
+	if front {
+		item := cs.items[0]
+		cs.items = append(cs.items[:0], cs.items[1:]...)
+		return item, nil
+	}
+
+	last := len(cs.items) - 1
+	item := cs.items[last]
+	cs.items = cs.items[:last]
+	return item, nil
+}
+
+// WaitForCount blocks until Count() >= n, or returns ctx.Err() if ctx is
+// cancelled first.
+func (cs *ConcurrentSlice[T]) WaitForCount(ctx context.Context, n int) error {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			cs.mutex.Lock()
+			cs.cond.Broadcast()
+			cs.mutex.Unlock()
+		case <-done:
+		}
+	}()
+
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	for len(cs.items) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cs.cond.Wait()
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package cslice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cslice "github.com/hyp3rd/concurrent-slice/pkg"
+)
+
+// TestBlockingPopWaitsForItem tests that BlockingPop unblocks once an item
+// is added from another goroutine.
+func TestBlockingPopWaitsForItem(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cs.Add(42)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	item, err := cs.BlockingPop(ctx)
+	if err != nil {
+		t.Fatalf("BlockingPop returned error: %v", err)
+	}
+	if item != 42 {
+		t.Errorf("Expected 42, got %d", item)
+	}
+}
+
+// TestBlockingPopCancelled tests that BlockingPop returns promptly once ctx
+// is cancelled, without ever receiving an item.
+func TestBlockingPopCancelled(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := cs.BlockingPop(ctx); err == nil {
+		t.Error("Expected an error from BlockingPop on cancelled context")
+	}
+}
+
+// TestWaitForCount tests that WaitForCount unblocks once enough items have
+// been added.
+func TestWaitForCount(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+
+	for i := 0; i < 2; i++ {
+		go cs.Add(i)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := cs.WaitForCount(ctx, 2); err != nil {
+		t.Fatalf("WaitForCount returned error: %v", err)
+	}
+}
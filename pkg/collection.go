@@ -0,0 +1,36 @@
+package cslice
+
+// Collection is the minimal contract for a thread-safe, unordered-access
+// container: add items, test membership, and walk the contents.
+type Collection[T any] interface {
+	// Add appends an item to the collection.
+	Add(item T)
+	// Contains reports whether the collection holds item.
+	Contains(item T) bool
+	// Count returns the number of items currently held.
+	Count() int
+	// Clear removes all items.
+	Clear()
+	// Iter iterates over the items, optionally buffering the returned channel.
+	Iter(buffered bool) <-chan ConcurrentSliceItem[T]
+}
+
+// List is a Collection that additionally guarantees ordering and supports
+// index-based access, in the style of a slice.
+type List[T any] interface {
+	Collection[T]
+	// Get returns the item at index and whether index was in range.
+	Get(index int) (T, bool)
+	// SetAt replaces the item at index, returning an error if index is out of range.
+	SetAt(index int, item T) error
+	// Insert places item at index, shifting subsequent items to the right.
+	Insert(index int, item T) error
+	// Delete removes the item at index, shifting subsequent items to the left.
+	Delete(index int)
+	// IndexOf returns the index of the first occurrence of item, and whether it was found.
+	IndexOf(item T) (int, bool)
+	// Snapshot returns a defensive copy of the current items.
+	Snapshot() []T
+	// Range iterates synchronously over a snapshot, stopping early if fn returns false.
+	Range(fn func(index int, value T) bool)
+}
@@ -5,35 +5,62 @@ import (
 	"sync"
 )
 
-// ConcurrentSlice type that can be safely shared between goroutines
-type ConcurrentSlice struct {
+// ConcurrentSlice type that can be safely shared between goroutines.
+type ConcurrentSlice[T any] struct {
 	mutex sync.RWMutex
-	items []interface{}
+	items []T
+	cond  *sync.Cond // broadcasts after every mutation; used by the blocking operations
 }
 
-// ConcurrentSliceItem is the type of a concurrent slice item
-type ConcurrentSliceItem struct {
+// ConcurrentSliceItem is the type of a concurrent slice item.
+type ConcurrentSliceItem[T any] struct {
 	Index int
-	Value interface{}
+	Value T
 }
 
-// NewConcurrentSlice Creates a new concurrent slice
-func NewConcurrentSlice() *ConcurrentSlice {
-	return &ConcurrentSlice{
-		items: make([]interface{}, 0),
+// NewConcurrentSlice creates a new concurrent slice and returns it as a List[T],
+// so callers can program against the abstract interface instead of the concrete type.
+func NewConcurrentSlice[T any]() List[T] {
+	return newConcurrentSlice[T]()
+}
+
+// NewConcurrentSliceAny creates a new concurrent slice of `any`, mirroring the
+// pre-generics API for callers not yet ready to parameterize their call sites.
+func NewConcurrentSliceAny() *ConcurrentSlice[any] {
+	return newConcurrentSlice[any]()
+}
+
+// newConcurrentSlice builds an empty, ready-to-use ConcurrentSlice[T]. Every
+// instance carries a *sync.Cond alongside its mutex (see BlockingPop,
+// BlockingPopBack and WaitForCount) so that blocking semantics are always
+// available; NewBlockingConcurrentSlice exists purely to make that intent
+// explicit at the call site.
+func newConcurrentSlice[T any]() *ConcurrentSlice[T] {
+	cs := &ConcurrentSlice[T]{
+		items: make([]T, 0),
 	}
+	cs.cond = sync.NewCond(&cs.mutex)
+	return cs
 }
 
-// Set Appends an item to the concurrent slice
-func (cs *ConcurrentSlice) Set(item interface{}) {
+// Add appends an item to the concurrent slice.
+func (cs *ConcurrentSlice[T]) Add(item T) {
 	cs.mutex.Lock()
 	defer cs.mutex.Unlock()
 
 	cs.items = append(cs.items, item)
+	cs.cond.Broadcast()
+}
+
+// Set appends an item to the concurrent slice.
+//
+// Deprecated: use Add. Set is kept for backward compatibility with the pre-generics API.
+func (cs *ConcurrentSlice[T]) Set(item T) {
+	cs.Add(item)
 }
 
 // SetAt sets an item at a specific index in the concurrent slice and returns an error if out of bounds.
-func (cs *ConcurrentSlice) SetAt(index int, item interface{}) error {
+func (cs *ConcurrentSlice[T]) SetAt(index int, item T) error {
 	cs.mutex.Lock()
 	defer cs.mutex.Unlock()
 
@@ -44,27 +71,45 @@ func (cs *ConcurrentSlice) SetAt(index int, item interface{}) error {
 	return nil
 }
 
-// SetMany appends multiple items to the concurrent slice
-func (cs *ConcurrentSlice) SetMany(items ...interface{}) {
+// Insert places item at index, shifting subsequent items to the right, and
+// returns an error if index is out of range.
+func (cs *ConcurrentSlice[T]) Insert(index int, item T) error {
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	if index < 0 || index > len(cs.items) {
+		return fmt.Errorf("index %d out of range", index)
+	}
+	cs.items = append(cs.items, item)
+	copy(cs.items[index+1:], cs.items[index:])
+	cs.items[index] = item
+	cs.cond.Broadcast()
+	return nil
+}
+
+// SetMany appends multiple items to the concurrent slice.
+func (cs *ConcurrentSlice[T]) SetMany(items ...T) {
 	cs.mutex.Lock()
 	defer cs.mutex.Unlock()
 
 	cs.items = append(cs.items, items...)
+	cs.cond.Broadcast()
 }
 
-// Get Gets an item from the concurrent slice
-func (cs *ConcurrentSlice) Get(index int) (interface{}, bool) {
+// Get gets an item from the concurrent slice.
+func (cs *ConcurrentSlice[T]) Get(index int) (T, bool) {
 	cs.mutex.RLock()
 	defer cs.mutex.RUnlock()
 
 	if index < 0 || index >= len(cs.items) {
-		return nil, false
+		var zero T
+		return zero, false
 	}
 	return cs.items[index], true
 }
 
 // Delete removes an item from the concurrent slice efficiently.
-func (cs *ConcurrentSlice) Delete(index int) {
+func (cs *ConcurrentSlice[T]) Delete(index int) {
 	cs.mutex.Lock()
 	defer cs.mutex.Unlock()
 
@@ -72,11 +117,12 @@ func (cs *ConcurrentSlice) Delete(index int) {
 		return
 	}
 	cs.items = append(cs.items[:index], cs.items[index+1:]...)
-	cs.items = append([]interface{}(nil), cs.items...) // Truncate slice to free memory if necessary
+	cs.items = append([]T(nil), cs.items...) // Truncate slice to free memory if necessary
+	cs.cond.Broadcast()
 }
 
-// Count returns the number of items in the slice
-func (cs *ConcurrentSlice) Count() int {
+// Count returns the number of items in the slice.
+func (cs *ConcurrentSlice[T]) Count() int {
 	cs.mutex.RLock()
 	defer cs.mutex.RUnlock()
 
@@ -84,74 +130,98 @@ func (cs *ConcurrentSlice) Count() int {
 }
 
 // Clear removes all items from the concurrent slice.
-func (cs *ConcurrentSlice) Clear() {
+func (cs *ConcurrentSlice[T]) Clear() {
 	cs.mutex.Lock()
 	defer cs.mutex.Unlock()
 
-	cs.items = nil // or cs.items = make([]interface{}, 0) to reset the slice without freeing the underlying array
+	cs.items = nil // or cs.items = make([]T, 0) to reset the slice without freeing the underlying array
+	cs.cond.Broadcast()
 }
 
 // Contains checks if the slice contains an item.
-func (cs *ConcurrentSlice) Contains(item interface{}) bool {
+func (cs *ConcurrentSlice[T]) Contains(item T) bool {
 	cs.mutex.RLock()
 	defer cs.mutex.RUnlock()
 
 	for _, v := range cs.items {
-		if v == item {
+		if any(v) == any(item) {
 			return true
 		}
 	}
 	return false
 }
 
-// Iter iterates over the items in the concurrent slice. It can optionally use a buffered channel.
-func (cs *ConcurrentSlice) Iter(buffered bool) <-chan ConcurrentSliceItem {
-	var c chan ConcurrentSliceItem
-	if buffered {
-		cs.mutex.RLock()
-		c = make(chan ConcurrentSliceItem, len(cs.items))
-		cs.mutex.RUnlock()
-	} else {
-		c = make(chan ConcurrentSliceItem)
+// IndexOf returns the index of the first occurrence of item, and whether it was found.
+func (cs *ConcurrentSlice[T]) IndexOf(item T) (int, bool) {
+	cs.mutex.RLock()
+	defer cs.mutex.RUnlock()
+
+	for i, v := range cs.items {
+		if any(v) == any(item) {
+			return i, true
+		}
 	}
+	return 0, false
+}
 
-	go func() {
-		cs.mutex.RLock()
-		defer cs.mutex.RUnlock()
-		defer close(c)
+// Snapshot returns a defensive copy of the slice's current items. Because it
+// is a copy, the caller can range over it, hold onto it, or hand it to
+// another goroutine without ever holding the slice's lock.
+func (cs *ConcurrentSlice[T]) Snapshot() []T {
+	cs.mutex.RLock()
+	defer cs.mutex.RUnlock()
 
-		for index, value := range cs.items {
-			c <- ConcurrentSliceItem{index, value}
-		}
-	}()
+	snapshot := make([]T, len(cs.items))
+	copy(snapshot, cs.items)
+	return snapshot
+}
 
-	return c
+// Range iterates synchronously over a snapshot of the items, calling fn for
+// each one in order and stopping early if fn returns false. Unlike Iter and
+// IterWithFunc, it uses no channel or goroutine, mirroring sync.Map.Range.
+func (cs *ConcurrentSlice[T]) Range(fn func(index int, value T) bool) {
+	for index, value := range cs.Snapshot() {
+		if !fn(index, value) {
+			break
+		}
+	}
 }
 
-// IterFunc type for handling items during iteration.
-type IterFunc func(item ConcurrentSliceItem) bool
+// Iter iterates over the items in the concurrent slice. It can optionally
+// use a buffered channel. The lock is held only long enough to take a
+// snapshot — a slow or stalled consumer never blocks writers.
+func (cs *ConcurrentSlice[T]) Iter(buffered bool) <-chan ConcurrentSliceItem[T] {
+	snapshot := cs.Snapshot()
 
-// IterWithFunc iterates over the items in the concurrent slice and allows early termination.
-func (cs *ConcurrentSlice) IterWithFunc(buffered bool, fn IterFunc) {
-	var c chan ConcurrentSliceItem
+	var c chan ConcurrentSliceItem[T]
 	if buffered {
-		cs.mutex.RLock()
-		c = make(chan ConcurrentSliceItem, len(cs.items))
-		cs.mutex.RUnlock()
+		c = make(chan ConcurrentSliceItem[T], len(snapshot))
 	} else {
-		c = make(chan ConcurrentSliceItem)
+		c = make(chan ConcurrentSliceItem[T])
 	}
 
 	go func() {
-		cs.mutex.RLock()
-		defer cs.mutex.RUnlock()
 		defer close(c)
-
-		for index, value := range cs.items {
-			if !fn(ConcurrentSliceItem{index, value}) {
-				break // Stop iteration based on the function's return value
-			}
-			c <- ConcurrentSliceItem{index, value}
+		for index, value := range snapshot {
+			c <- ConcurrentSliceItem[T]{index, value}
 		}
 	}()
+
+	return c
+}
+
+// IterFunc is the type for handling items during iteration.
+type IterFunc[T any] func(item ConcurrentSliceItem[T]) bool
+
+// IterWithFunc iterates synchronously over a snapshot of the items, calling
+// fn for each one in order and stopping early if fn returns false. There is
+// no channel or goroutine involved — like Range, just with a
+// ConcurrentSliceItem[T] in the callback instead of separate index/value
+// arguments — so there is nothing to drain and nothing that can leak.
+func (cs *ConcurrentSlice[T]) IterWithFunc(fn IterFunc[T]) {
+	for index, value := range cs.Snapshot() {
+		if !fn(ConcurrentSliceItem[T]{index, value}) {
+			break // Stop iteration based on the function's return value
+		}
+	}
 }
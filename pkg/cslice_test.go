@@ -4,12 +4,12 @@ import (
 	"sync"
 	"testing"
 
-	cslice "https://github.com/hyp3rd/concurrent-slice/pkg"
+	cslice "github.com/hyp3rd/concurrent-slice/pkg"
 )
 
 // TestSetAndGet tests the basic Set and Get methods for correctness.
 func TestSetAndGet(t *testing.T) {
-	cs := cslice.NewConcurrentSlice()
+	cs := cslice.NewConcurrentSliceAny()
 	cs.Set("hello")
 	cs.Set("world")
 
@@ -23,7 +23,7 @@ func TestSetAndGet(t *testing.T) {
 
 // TestConcurrency tests the ConcurrentSlice for safe concurrent access.
 func TestConcurrency(t *testing.T) {
-	cs := cslice.NewConcurrentSlice()
+	cs := cslice.NewConcurrentSliceAny()
 	var wg sync.WaitGroup
 
 	// Start several goroutines that add items to the slice concurrently.
@@ -44,13 +44,13 @@ func TestConcurrency(t *testing.T) {
 
 // TestDelete tests the deletion functionality.
 func TestDelete(t *testing.T) {
-	cs := cslice.NewConcurrentSlice()
-	items := []interface{}{"a", "b", "c", "d", "e"}
+	cs := cslice.NewConcurrentSliceAny()
+	items := []any{"a", "b", "c", "d", "e"}
 	cs.SetMany(items...)
 
 	cs.Delete(2) // Remove "c"
 
-	expected := []interface{}{"a", "b", "d", "e"}
+	expected := []any{"a", "b", "d", "e"}
 	for i, exp := range expected {
 		if item, ok := cs.Get(i); !ok || item != exp {
 			t.Errorf("After Delete, expected %v at index %d, got %v", exp, i, item)
@@ -60,8 +60,8 @@ func TestDelete(t *testing.T) {
 
 // TestIter tests the iteration functionality.
 func TestIter(t *testing.T) {
-	cs := cslice.NewConcurrentSlice()
-	items := []interface{}{"one", "two", "three"}
+	cs := cslice.NewConcurrentSliceAny()
+	items := []any{"one", "two", "three"}
 	cs.SetMany(items...)
 
 	ch := cs.Iter(false)
@@ -76,7 +76,7 @@ func TestIter(t *testing.T) {
 
 // TestClear tests the clear functionality.
 func TestClear(t *testing.T) {
-	cs := cslice.NewConcurrentSlice()
+	cs := cslice.NewConcurrentSliceAny()
 	cs.SetMany("a", "b", "c")
 	cs.Clear()
 
@@ -87,7 +87,7 @@ func TestClear(t *testing.T) {
 
 // TestContains checks if the Contains method works as expected.
 func TestContains(t *testing.T) {
-	cs := cslice.NewConcurrentSlice()
+	cs := cslice.NewConcurrentSliceAny()
 	cs.Set("hello")
 	cs.Set("world")
 
@@ -98,3 +98,28 @@ func TestContains(t *testing.T) {
 		t.Errorf("Contains found 'missing' which was not expected")
 	}
 }
+
+// TestGenericList exercises NewConcurrentSlice against the List[T] interface
+// to make sure the generic constructor and interface surface line up.
+func TestGenericList(t *testing.T) {
+	var list cslice.List[int] = cslice.NewConcurrentSlice[int]()
+	list.Add(1)
+	list.Add(2)
+	list.Add(3)
+
+	if count := list.Count(); count != 3 {
+		t.Errorf("Expected 3 items, got %d", count)
+	}
+	if !list.Contains(2) {
+		t.Errorf("Contains failed to find 2")
+	}
+	if idx, ok := list.IndexOf(3); !ok || idx != 2 {
+		t.Errorf("Expected IndexOf(3) == 2, got %d, %v", idx, ok)
+	}
+	if err := list.Insert(1, 99); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+	if item, ok := list.Get(1); !ok || item != 99 {
+		t.Errorf("Expected 99 at index 1, got %v", item)
+	}
+}
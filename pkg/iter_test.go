@@ -0,0 +1,119 @@
+package cslice_test
+
+import (
+	"testing"
+	"time"
+
+	cslice "github.com/hyp3rd/concurrent-slice/pkg"
+)
+
+// TestIterSlowConsumerDoesNotBlockWriters is a regression test for the
+// RLock-held-during-send deadlock risk: Iter takes a snapshot and releases
+// the lock before sending, so a consumer that never drains the channel must
+// not prevent a concurrent Set from completing.
+func TestIterSlowConsumerDoesNotBlockWriters(t *testing.T) {
+	cs := cslice.NewConcurrentSlice[int]()
+	cs.Add(1)
+	cs.Add(2)
+	cs.Add(3)
+
+	ch := cs.Iter(false) // unbuffered: the goroutine blocks on its first send
+
+	done := make(chan struct{})
+	go func() {
+		cs.Add(4)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Add blocked while a slow Iter consumer never drained the channel")
+	}
+
+	if count := cs.Count(); count != 4 {
+		t.Errorf("Expected 4 items, got %d", count)
+	}
+
+	// Drain the channel so its goroutine doesn't leak.
+	for range ch {
+	}
+}
+
+// TestIterWithFuncEarlyTermination tests that fn returning false stops the
+// iteration before it reaches the end of the snapshot.
+func TestIterWithFuncEarlyTermination(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+	cs.Add(1)
+	cs.Add(2)
+	cs.Add(3)
+
+	seen := 0
+	cs.IterWithFunc(func(item cslice.ConcurrentSliceItem[int]) bool {
+		seen++
+		return false // stop immediately
+	})
+
+	if seen != 1 {
+		t.Errorf("Expected iteration to stop after 1 item, saw %d", seen)
+	}
+}
+
+// TestIterWithFuncRunsToCompletion is a regression test: IterWithFunc used
+// to allocate a channel and a goroutine that sent into it after calling fn,
+// but never returned or drained that channel. Once fn returned true for
+// every item, the goroutine blocked forever on its first unbuffered send,
+// leaking it on every call. IterWithFunc is now fully synchronous, so a run
+// where fn always returns true must simply visit every item and return.
+func TestIterWithFuncRunsToCompletion(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+	cs.Add(1)
+	cs.Add(2)
+	cs.Add(3)
+
+	var visited []int
+	cs.IterWithFunc(func(item cslice.ConcurrentSliceItem[int]) bool {
+		visited = append(visited, item.Value)
+		return true
+	})
+
+	if len(visited) != 3 || visited[0] != 1 || visited[1] != 2 || visited[2] != 3 {
+		t.Errorf("Expected to visit [1 2 3], got %v", visited)
+	}
+}
+
+// TestSnapshotIsDefensiveCopy tests that mutating the slice after taking a
+// Snapshot does not affect the returned copy.
+func TestSnapshotIsDefensiveCopy(t *testing.T) {
+	cs := cslice.NewConcurrentSlice[int]()
+	cs.Add(1)
+	cs.Add(2)
+
+	snap := cs.Snapshot()
+	cs.Add(3)
+
+	if len(snap) != 2 {
+		t.Fatalf("Expected snapshot of length 2, got %d", len(snap))
+	}
+	if snap[0] != 1 || snap[1] != 2 {
+		t.Errorf("Unexpected snapshot contents: %v", snap)
+	}
+}
+
+// TestRangeStopsEarly tests that Range honors a false return from fn.
+func TestRangeStopsEarly(t *testing.T) {
+	cs := cslice.NewConcurrentSlice[int]()
+	cs.Add(1)
+	cs.Add(2)
+	cs.Add(3)
+
+	var visited []int
+	cs.Range(func(index int, value int) bool {
+		visited = append(visited, value)
+		return value != 2
+	})
+
+	if len(visited) != 2 {
+		t.Errorf("Expected Range to stop after 2 items, visited %v", visited)
+	}
+}
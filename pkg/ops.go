@@ -0,0 +1,130 @@
+package cslice
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// Map applies fn to a snapshot of cs's items and returns a new
+// ConcurrentSlice holding the results, in the same order.
+func Map[T, U any](cs *ConcurrentSlice[T], fn func(T) U) *ConcurrentSlice[U] {
+	snapshot := cs.Snapshot()
+
+	mapped := make([]U, len(snapshot))
+	for i, v := range snapshot {
+		mapped[i] = fn(v)
+	}
+
+	out := newConcurrentSlice[U]()
+	out.SetMany(mapped...)
+	return out
+}
+
+// Filter returns a new ConcurrentSlice holding the items of a snapshot of cs
+// for which pred returns true, in the same order.
+func Filter[T any](cs *ConcurrentSlice[T], pred func(T) bool) *ConcurrentSlice[T] {
+	snapshot := cs.Snapshot()
+
+	out := newConcurrentSlice[T]()
+	for _, v := range snapshot {
+		if pred(v) {
+			out.Add(v)
+		}
+	}
+	return out
+}
+
+// Reduce folds a snapshot of cs's items into a single value, starting from
+// init and applying fn left to right.
+func Reduce[T, A any](cs *ConcurrentSlice[T], init A, fn func(A, T) A) A {
+	acc := init
+	for _, v := range cs.Snapshot() {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// Find returns the first item in a snapshot of cs for which pred returns
+// true, along with its index. The third return value is false if no item matched.
+func Find[T any](cs *ConcurrentSlice[T], pred func(T) bool) (T, int, bool) {
+	for i, v := range cs.Snapshot() {
+		if pred(v) {
+			return v, i, true
+		}
+	}
+	var zero T
+	return zero, -1, false
+}
+
+// Sort orders cs's items according to less. Like the other operations here,
+// less runs against a snapshot taken up front — never under cs's own lock —
+// so a less that calls back into cs (e.g. Get, Count) cannot deadlock. The
+// sorted result is then written back atomically under the write lock.
+func Sort[T any](cs *ConcurrentSlice[T], less func(T, T) bool) {
+	snapshot := cs.Snapshot()
+
+	sort.Slice(snapshot, func(i, j int) bool {
+		return less(snapshot[i], snapshot[j])
+	})
+
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	cs.items = snapshot
+	cs.cond.Broadcast()
+}
+
+// parallelMapChunkSize picks a chunk size that spreads n items evenly across
+// runtime.NumCPU() workers.
+func parallelMapChunkSize(n int) int {
+	workers := runtime.NumCPU()
+	if workers < 1 {
+		workers = 1
+	}
+	chunk := n / workers
+	if n%workers != 0 {
+		chunk++
+	}
+	if chunk < 1 {
+		chunk = 1
+	}
+	return chunk
+}
+
+// ParallelMap behaves like Map but fans the work out across runtime.NumCPU()
+// workers, each processing a chunk of chunkSize items. A chunkSize <= 0 picks
+// a chunk size that spreads the snapshot evenly across the available workers.
+func ParallelMap[T, U any](cs *ConcurrentSlice[T], fn func(T) U, chunkSize int) *ConcurrentSlice[U] {
+	snapshot := cs.Snapshot()
+	if chunkSize <= 0 {
+		chunkSize = parallelMapChunkSize(len(snapshot))
+	}
+
+	mapped := make([]U, len(snapshot))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.NumCPU())
+	for start := 0; start < len(snapshot); start += chunkSize {
+		end := start + chunkSize
+		if end > len(snapshot) {
+			end = len(snapshot)
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(start, end int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			for i := start; i < end; i++ {
+				mapped[i] = fn(snapshot[i])
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	out := newConcurrentSlice[U]()
+	out.SetMany(mapped...)
+	return out
+}
@@ -0,0 +1,102 @@
+package cslice_test
+
+import (
+	"testing"
+
+	cslice "github.com/hyp3rd/concurrent-slice/pkg"
+)
+
+// TestMap tests that Map applies fn to every item and preserves order.
+func TestMap(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+	cs.SetMany(1, 2, 3)
+
+	doubled := cslice.Map(cs, func(v int) int { return v * 2 })
+
+	want := []int{2, 4, 6}
+	for i, w := range want {
+		if item, ok := doubled.Get(i); !ok || item != w {
+			t.Errorf("Expected %d at index %d, got %v", w, i, item)
+		}
+	}
+}
+
+// TestFilter tests that Filter keeps only the items matching pred.
+func TestFilter(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+	cs.SetMany(1, 2, 3, 4, 5)
+
+	evens := cslice.Filter(cs, func(v int) bool { return v%2 == 0 })
+
+	if count := evens.Count(); count != 2 {
+		t.Fatalf("Expected 2 items, got %d", count)
+	}
+	if item, ok := evens.Get(0); !ok || item != 2 {
+		t.Errorf("Expected 2 at index 0, got %v", item)
+	}
+	if item, ok := evens.Get(1); !ok || item != 4 {
+		t.Errorf("Expected 4 at index 1, got %v", item)
+	}
+}
+
+// TestReduce tests that Reduce folds items left to right.
+func TestReduce(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+	cs.SetMany(1, 2, 3, 4)
+
+	sum := cslice.Reduce(cs, 0, func(acc, v int) int { return acc + v })
+	if sum != 10 {
+		t.Errorf("Expected 10, got %d", sum)
+	}
+}
+
+// TestFind tests that Find returns the first matching item and its index.
+func TestFind(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[string]()
+	cs.SetMany("a", "bb", "ccc")
+
+	item, index, ok := cslice.Find(cs, func(v string) bool { return len(v) == 2 })
+	if !ok || item != "bb" || index != 1 {
+		t.Errorf("Expected ('bb', 1, true), got (%q, %d, %v)", item, index, ok)
+	}
+
+	if _, _, ok := cslice.Find(cs, func(v string) bool { return len(v) == 9 }); ok {
+		t.Errorf("Expected no match")
+	}
+}
+
+// TestSort tests that Sort orders the items in place.
+func TestSort(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+	cs.SetMany(3, 1, 4, 1, 5, 9, 2, 6)
+
+	cslice.Sort(cs, func(a, b int) bool { return a < b })
+
+	want := []int{1, 1, 2, 3, 4, 5, 6, 9}
+	for i, w := range want {
+		if item, ok := cs.Get(i); !ok || item != w {
+			t.Errorf("Expected %d at index %d, got %v", w, i, item)
+		}
+	}
+}
+
+// TestParallelMap tests that ParallelMap produces the same results as Map,
+// regardless of chunk size.
+func TestParallelMap(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+	for i := 0; i < 50; i++ {
+		cs.Add(i)
+	}
+
+	squared := cslice.ParallelMap(cs, func(v int) int { return v * v }, 7)
+
+	if count := squared.Count(); count != 50 {
+		t.Fatalf("Expected 50 items, got %d", count)
+	}
+	for i := 0; i < 50; i++ {
+		item, ok := squared.Get(i)
+		if !ok || item != i*i {
+			t.Errorf("Expected %d at index %d, got %v", i*i, i, item)
+		}
+	}
+}
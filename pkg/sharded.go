@@ -0,0 +1,209 @@
+package cslice
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// defaultShardCount is the shard count used when NewShardedConcurrentSlice
+// is called with a non-positive value.
+const defaultShardCount = 32
+
+// shard holds one partition of a ShardedConcurrentSlice's items behind its
+// own lock.
+type shard[T any] struct {
+	mutex sync.RWMutex
+	items []T
+}
+
+// ShardedConcurrentSlice partitions its items across N independently-locked
+// shards so concurrent writers contend on separate locks instead of a single
+// global mutex, trading a simple linear index for better write throughput.
+//
+// Because each logical index is permanently pinned to one shard and offset
+// (shard = i % N, offset = i / N), Delete cannot renumber later items without
+// an expensive full reshuffle across shards — see Delete and Count.
+type ShardedConcurrentSlice[T any] struct {
+	shards  []*shard[T]
+	counter uint64 // atomic round-robin counter consulted by Set
+}
+
+// NewShardedConcurrentSlice creates a ShardedConcurrentSlice partitioned
+// into shards independent locks. A non-positive shards falls back to
+// defaultShardCount.
+func NewShardedConcurrentSlice[T any](shards int) *ShardedConcurrentSlice[T] {
+	if shards <= 0 {
+		shards = defaultShardCount
+	}
+
+	ss := &ShardedConcurrentSlice[T]{
+		shards: make([]*shard[T], shards),
+	}
+	for i := range ss.shards {
+		ss.shards[i] = &shard[T]{items: make([]T, 0)}
+	}
+	return ss
+}
+
+// Set appends an item, picking a shard round-robin via an atomic counter.
+func (ss *ShardedConcurrentSlice[T]) Set(item T) {
+	n := atomic.AddUint64(&ss.counter, 1) - 1
+	s := ss.shards[n%uint64(len(ss.shards))]
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.items = append(s.items, item)
+}
+
+// Get maps logical index i to its shard via an interleaved scheme
+// (shard = i % N, offset = i / N) and returns the item stored there.
+//
+// After a Delete, indices are no longer contiguous: the deleted index
+// becomes a permanent hole (Get returns false for it) while every later
+// index keeps addressing the same shard/offset it always did — nothing is
+// renumbered. The canonical `for i := 0; i < ss.Count(); i++ { ss.Get(i) }`
+// traversal is therefore unsafe once any Delete has happened, since
+// Count() no longer equals the highest valid index plus one; it will
+// silently stop before reaching items that still exist. Use Iter or
+// IterWithFunc for a full traversal — they walk every addressable
+// shard/offset pair, not just [0, Count()).
+func (ss *ShardedConcurrentSlice[T]) Get(index int) (T, bool) {
+	var zero T
+	if index < 0 {
+		return zero, false
+	}
+
+	n := len(ss.shards)
+	s := ss.shards[index%n]
+	offset := index / n
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if offset < 0 || offset >= len(s.items) {
+		return zero, false
+	}
+	return s.items[offset], true
+}
+
+// Count returns the total number of items across all shards.
+//
+// Count is a live item count, not an upper bound on valid indices: once
+// Delete has removed an item that isn't the last one, Count is smaller than
+// (highest valid index + 1), because the hole Delete leaves behind is never
+// reclaimed by renumbering later items (see Get). Don't use Count as a loop
+// bound over Get; use Iter or IterWithFunc instead.
+func (ss *ShardedConcurrentSlice[T]) Count() int {
+	total := 0
+	for _, s := range ss.shards {
+		s.mutex.RLock()
+		total += len(s.items)
+		s.mutex.RUnlock()
+	}
+	return total
+}
+
+// Delete removes the item at logical index i, shifting the items within the
+// affected shard left by one and updating that shard's length.
+//
+// That shift is local to the one shard: it never touches the other N-1
+// shards, so it cannot renumber the logical indices of items they hold.
+// The net effect is a permanent hole at the tail of the affected shard's
+// addressable range rather than a fully compacted index space — see Get
+// and Count for what that means for callers.
+func (ss *ShardedConcurrentSlice[T]) Delete(index int) {
+	if index < 0 {
+		return
+	}
+
+	n := len(ss.shards)
+	s := ss.shards[index%n]
+	offset := index / n
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if offset < 0 || offset >= len(s.items) {
+		return
+	}
+	s.items = append(s.items[:offset], s.items[offset+1:]...)
+}
+
+// snapshotOrdered copies every item into a single slice in logical index
+// order, taking each shard's RLock just long enough to read its items.
+//
+// The logical index it assigns each item is the same shard = i % N,
+// offset = i / N formula Get and Delete use, so a hole left by a Delete
+// (the affected shard now has fewer items than its peers) is skipped rather
+// than compacted away. Compacting would renumber every later item and make
+// Iter disagree with Get about which index holds which value.
+func (ss *ShardedConcurrentSlice[T]) snapshotOrdered() []ConcurrentSliceItem[T] {
+	n := len(ss.shards)
+	perShard := make([][]T, n)
+	maxLen := 0
+	for i, s := range ss.shards {
+		s.mutex.RLock()
+		perShard[i] = append([]T(nil), s.items...)
+		s.mutex.RUnlock()
+		if len(perShard[i]) > maxLen {
+			maxLen = len(perShard[i])
+		}
+	}
+
+	items := make([]ConcurrentSliceItem[T], 0, maxLen*n)
+	for offset := 0; offset < maxLen; offset++ {
+		for shardIdx := 0; shardIdx < n; shardIdx++ {
+			if offset < len(perShard[shardIdx]) {
+				index := offset*n + shardIdx
+				items = append(items, ConcurrentSliceItem[T]{index, perShard[shardIdx][offset]})
+			}
+		}
+	}
+	return items
+}
+
+// Iter iterates over the items in logical index order (consistent with
+// Get's shard/offset addressing — see snapshotOrdered). It can optionally
+// use a buffered channel.
+func (ss *ShardedConcurrentSlice[T]) Iter(buffered bool) <-chan ConcurrentSliceItem[T] {
+	ordered := ss.snapshotOrdered()
+
+	var c chan ConcurrentSliceItem[T]
+	if buffered {
+		c = make(chan ConcurrentSliceItem[T], len(ordered))
+	} else {
+		c = make(chan ConcurrentSliceItem[T])
+	}
+
+	go func() {
+		defer close(c)
+		for _, item := range ordered {
+			c <- item
+		}
+	}()
+
+	return c
+}
+
+// IterWithFunc iterates over the items in logical index order (see
+// snapshotOrdered) and allows early termination.
+func (ss *ShardedConcurrentSlice[T]) IterWithFunc(buffered bool, fn IterFunc[T]) {
+	ordered := ss.snapshotOrdered()
+
+	var c chan ConcurrentSliceItem[T]
+	if buffered {
+		c = make(chan ConcurrentSliceItem[T], len(ordered))
+	} else {
+		c = make(chan ConcurrentSliceItem[T])
+	}
+
+	go func() {
+		defer close(c)
+		for _, item := range ordered {
+			if !fn(item) {
+				break
+			}
+			c <- item
+		}
+	}()
+}
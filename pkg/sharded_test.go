@@ -0,0 +1,188 @@
+package cslice_test
+
+import (
+	"sync"
+	"testing"
+
+	cslice "github.com/hyp3rd/concurrent-slice/pkg"
+)
+
+// TestShardedSetAndCount tests that items set concurrently across shards are
+// all accounted for.
+func TestShardedSetAndCount(t *testing.T) {
+	ss := cslice.NewShardedConcurrentSlice[int](4)
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(val int) {
+			defer wg.Done()
+			ss.Set(val)
+		}(i)
+	}
+	wg.Wait()
+
+	if count := ss.Count(); count != 100 {
+		t.Errorf("Expected 100 items, got %d", count)
+	}
+}
+
+// TestShardedCountIsNotAValidGetLoopBoundAfterDelete pins the documented
+// limitation on Get/Count/Delete: deleting an item that isn't the last one
+// leaves a permanent hole, so Count() is no longer the exclusive upper
+// bound of valid indices and a `for i := 0; i < Count(); i++ { Get(i) }`
+// loop silently misses items past the hole. Iter (used here) is the
+// documented safe full traversal.
+func TestShardedCountIsNotAValidGetLoopBoundAfterDelete(t *testing.T) {
+	ss := cslice.NewShardedConcurrentSlice[string](2)
+	ss.Set("A") // shard 0, offset 0 -> index 0
+	ss.Set("B") // shard 1, offset 0 -> index 1
+	ss.Set("C") // shard 0, offset 1 -> index 2
+	ss.Set("D") // shard 1, offset 1 -> index 3
+
+	ss.Delete(0) // remove "A", leaving a hole at index 0
+
+	if count := ss.Count(); count != 3 {
+		t.Fatalf("Expected Count() == 3, got %d", count)
+	}
+
+	var viaCountLoop []string
+	for i := 0; i < ss.Count(); i++ {
+		if v, ok := ss.Get(i); ok {
+			viaCountLoop = append(viaCountLoop, v)
+		}
+	}
+	// The Count()-bounded loop only reaches indices 0..2 and misses "D" at
+	// index 3 entirely — this is the documented gotcha, not a bug fix.
+	if len(viaCountLoop) != 2 {
+		t.Fatalf("Expected the Count()-bounded loop to miss an item, got %v", viaCountLoop)
+	}
+
+	var viaIter []string
+	for item := range ss.Iter(true) {
+		viaIter = append(viaIter, item.Value)
+	}
+	if len(viaIter) != 3 {
+		t.Fatalf("Expected Iter to surface all 3 surviving items, got %v", viaIter)
+	}
+}
+
+// TestShardedGetAndDelete tests that Get and Delete honor the interleaved
+// (shard = i % N, offset = i / N) indexing scheme.
+func TestShardedGetAndDelete(t *testing.T) {
+	ss := cslice.NewShardedConcurrentSlice[string](2)
+	ss.Set("a") // shard 0, offset 0
+	ss.Set("b") // shard 1, offset 0
+	ss.Set("c") // shard 0, offset 1
+	ss.Set("d") // shard 1, offset 1
+
+	if item, ok := ss.Get(2); !ok || item != "c" {
+		t.Errorf("Expected 'c' at index 2, got %v", item)
+	}
+
+	ss.Delete(2) // remove "c" from shard 0
+
+	if _, ok := ss.Get(2); ok {
+		t.Errorf("Expected index 2 to be gone after Delete")
+	}
+	if count := ss.Count(); count != 3 {
+		t.Errorf("Expected 3 items after Delete, got %d", count)
+	}
+}
+
+// TestShardedGetAndIterAgreeAfterDelete is a regression test: Iter must
+// report each surviving item at the exact logical index Get would return
+// for it, including leaving a hole where the deleted item used to be,
+// rather than compacting indices across shards.
+func TestShardedGetAndIterAgreeAfterDelete(t *testing.T) {
+	ss := cslice.NewShardedConcurrentSlice[string](2)
+	ss.Set("a") // shard 0, offset 0 -> index 0
+	ss.Set("b") // shard 1, offset 0 -> index 1
+	ss.Set("c") // shard 0, offset 1 -> index 2
+	ss.Set("d") // shard 1, offset 1 -> index 3
+
+	ss.Delete(2) // remove "c", leaving a hole at index 2
+
+	for item := range ss.Iter(true) {
+		got, ok := ss.Get(item.Index)
+		if !ok || got != item.Value {
+			t.Errorf("Iter produced %v at index %d, but Get(%d) = (%v, %v)", item.Value, item.Index, item.Index, got, ok)
+		}
+	}
+
+	if _, ok := ss.Get(2); ok {
+		t.Errorf("Expected index 2 to be a hole after Delete")
+	}
+	if item, ok := ss.Get(3); !ok || item != "d" {
+		t.Errorf("Expected 'd' still at index 3 after Delete, got %v, %v", item, ok)
+	}
+}
+
+// TestShardedIterLogicalOrder tests that Iter walks shards in logical index order.
+func TestShardedIterLogicalOrder(t *testing.T) {
+	ss := cslice.NewShardedConcurrentSlice[int](3)
+	for i := 0; i < 9; i++ {
+		ss.Set(i)
+	}
+
+	var got []int
+	for item := range ss.Iter(true) {
+		got = append(got, item.Value)
+	}
+	if len(got) != 9 {
+		t.Fatalf("Expected 9 items, got %d", len(got))
+	}
+	for i, v := range got {
+		if v != i {
+			t.Errorf("Expected value %d at logical index %d, got %d", i, i, v)
+		}
+	}
+}
+
+// TestShardedDefaultShardCount tests that a non-positive shard count falls
+// back to the default.
+func TestShardedDefaultShardCount(t *testing.T) {
+	ss := cslice.NewShardedConcurrentSlice[int](0)
+	ss.Set(1)
+	if count := ss.Count(); count != 1 {
+		t.Errorf("Expected 1 item, got %d", count)
+	}
+}
+
+func mixedConcurrencyWorkload(b *testing.B, set func(int), get func(int) (int, bool)) {
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%4 == 0 {
+				set(i)
+			} else {
+				get(i % 100)
+			}
+			i++
+		}
+	})
+}
+
+// BenchmarkConcurrentSlice_MixedConcurrency benchmarks the single-mutex
+// ConcurrentSlice under a mixed read/write workload.
+func BenchmarkConcurrentSlice_MixedConcurrency(b *testing.B) {
+	cs := cslice.NewConcurrentSlice[int]()
+	for i := 0; i < 100; i++ {
+		cs.Add(i)
+	}
+
+	b.ResetTimer()
+	mixedConcurrencyWorkload(b, cs.Add, cs.Get)
+}
+
+// BenchmarkShardedConcurrentSlice_MixedConcurrency benchmarks the sharded
+// implementation under the same mixed read/write workload.
+func BenchmarkShardedConcurrentSlice_MixedConcurrency(b *testing.B) {
+	ss := cslice.NewShardedConcurrentSlice[int](32)
+	for i := 0; i < 100; i++ {
+		ss.Set(i)
+	}
+
+	b.ResetTimer()
+	mixedConcurrencyWorkload(b, ss.Set, ss.Get)
+}
@@ -0,0 +1,110 @@
+package cslice
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// txLockPollInterval is the backoff between TryLock attempts while WithTx
+// waits for the write lock without blocking indefinitely on ctx.
+const txLockPollInterval = time.Millisecond
+
+// Tx is a handle onto a ConcurrentSlice's items, valid only for the
+// duration of a WithTx call. Its operations run directly against the
+// underlying slice without taking the lock themselves, since WithTx already
+// holds it for the whole batch.
+type Tx[T any] struct {
+	cs *ConcurrentSlice[T]
+}
+
+// Get returns the item at index and whether index was in range.
+func (tx *Tx[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= len(tx.cs.items) {
+		var zero T
+		return zero, false
+	}
+	return tx.cs.items[index], true
+}
+
+// SetAt replaces the item at index, returning an error if index is out of range.
+func (tx *Tx[T]) SetAt(index int, item T) error {
+	if index < 0 || index >= len(tx.cs.items) {
+		return fmt.Errorf("index %d out of range", index)
+	}
+	tx.cs.items[index] = item
+	return nil
+}
+
+// Append adds item to the end of the slice.
+func (tx *Tx[T]) Append(item T) {
+	tx.cs.items = append(tx.cs.items, item)
+}
+
+// Delete removes the item at index, returning an error if index is out of range.
+func (tx *Tx[T]) Delete(index int) error {
+	if index < 0 || index >= len(tx.cs.items) {
+		return fmt.Errorf("index %d out of range", index)
+	}
+	tx.cs.items = append(tx.cs.items[:index], tx.cs.items[index+1:]...)
+	return nil
+}
+
+// Count returns the number of items currently in the slice.
+func (tx *Tx[T]) Count() int {
+	return len(tx.cs.items)
+}
+
+// WithTx acquires the write lock once and runs fn against a Tx handle,
+// letting fn perform a compound read-modify-write sequence (e.g. "insert if
+// not contains") that the one-shot methods cannot express safely. If fn
+// returns an error, every change it made is rolled back; otherwise the batch
+// commits as one atomic step.
+//
+// WithTx honors ctx while it waits for the lock: if ctx is cancelled before
+// the lock is acquired, it returns ctx.Err() without blocking.
+//
+// A panic inside fn also rolls back, same as a returned error, so a batch
+// never leaves the slice in a partially-applied state.
+func (cs *ConcurrentSlice[T]) WithTx(ctx context.Context, fn func(tx *Tx[T]) error) (err error) {
+	if err := cs.lockForTx(ctx); err != nil {
+		return err
+	}
+	defer cs.mutex.Unlock()
+
+	backup := append([]T(nil), cs.items...)
+	committed := false
+	defer func() {
+		if !committed {
+			cs.items = backup
+		}
+	}()
+
+	if err = fn(&Tx[T]{cs: cs}); err != nil {
+		return err
+	}
+
+	committed = true
+	cs.cond.Broadcast()
+	return nil
+}
+
+// lockForTx acquires cs.mutex for writing, polling via TryLock so that a
+// cancelled ctx is noticed promptly instead of blocking on Lock.
+func (cs *ConcurrentSlice[T]) lockForTx(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for {
+		if cs.mutex.TryLock() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(txLockPollInterval):
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package cslice_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cslice "github.com/hyp3rd/concurrent-slice/pkg"
+)
+
+// TestWithTxCommits tests that a successful fn commits all of its changes atomically.
+func TestWithTxCommits(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[string]()
+	cs.SetMany("a", "b")
+
+	err := cs.WithTx(context.Background(), func(tx *cslice.Tx[string]) error {
+		if tx.Count() != 2 {
+			t.Fatalf("Expected 2 items inside tx, got %d", tx.Count())
+		}
+		tx.Append("c")
+		return tx.SetAt(0, "A")
+	})
+	if err != nil {
+		t.Fatalf("WithTx returned error: %v", err)
+	}
+
+	if item, ok := cs.Get(0); !ok || item != "A" {
+		t.Errorf("Expected 'A' at index 0, got %v", item)
+	}
+	if count := cs.Count(); count != 3 {
+		t.Errorf("Expected 3 items after commit, got %d", count)
+	}
+}
+
+// TestWithTxRollsBack tests that an error from fn rolls back every change it made.
+func TestWithTxRollsBack(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[string]()
+	cs.SetMany("a", "b")
+
+	wantErr := errors.New("boom")
+	err := cs.WithTx(context.Background(), func(tx *cslice.Tx[string]) error {
+		tx.Append("c")
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Expected %v, got %v", wantErr, err)
+	}
+
+	if count := cs.Count(); count != 2 {
+		t.Errorf("Expected rollback to leave 2 items, got %d", count)
+	}
+}
+
+// TestWithTxRollsBackOnPanic tests that a panic inside fn rolls back every
+// change it made, same as a returned error.
+func TestWithTxRollsBackOnPanic(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[string]()
+	cs.SetMany("a", "b")
+
+	func() {
+		defer func() { recover() }()
+		cs.WithTx(context.Background(), func(tx *cslice.Tx[string]) error {
+			tx.Append("c")
+			panic("boom")
+		})
+	}()
+
+	if count := cs.Count(); count != 2 {
+		t.Errorf("Expected rollback to leave 2 items, got %d", count)
+	}
+}
+
+// TestWithTxCancelledContext tests that WithTx returns ctx.Err() without
+// blocking when ctx is already cancelled before the lock is acquired.
+func TestWithTxCancelledContext(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	err := cs.WithTx(ctx, func(tx *cslice.Tx[int]) error {
+		called = true
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if called {
+		t.Error("fn should not run once ctx is already cancelled")
+	}
+}
+
+// TestWithTxCancelledWhileWaiting tests that a WithTx call waiting on the
+// lock returns promptly once ctx is cancelled, rather than waiting for the
+// lock to free up.
+func TestWithTxCancelledWhileWaiting(t *testing.T) {
+	cs := cslice.NewBlockingConcurrentSlice[int]()
+
+	unblock := make(chan struct{})
+	holding := make(chan struct{})
+	go cs.WithTx(context.Background(), func(tx *cslice.Tx[int]) error {
+		close(holding)
+		<-unblock
+		return nil
+	})
+	<-holding
+	defer close(unblock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := cs.WithTx(ctx, func(tx *cslice.Tx[int]) error { return nil })
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("WithTx took too long to notice cancellation: %v", elapsed)
+	}
+}